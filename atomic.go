@@ -0,0 +1,65 @@
+package lmap
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the provided value. The loaded result
+// is true if the value was already present. Unlike calling Contains/Get
+// followed by Put, this is performed under a single write lock so two
+// concurrent callers can never both "win" the store.
+func (m *LockingMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	if existing, exists := m.Underlying[key]; exists {
+		return existing, true
+	}
+	m.Underlying[key] = value
+	m.emitPut(key, value, value, false)
+	m.invalidateSnapshot()
+	return value, false
+}
+
+// LoadOrCompute returns the existing value for the key if present.
+// Otherwise, it calls compute, stores the result and returns it. The
+// loaded result is true if the value was already present. compute is
+// called while the write lock is held, so it must not call back into
+// this map or it will deadlock.
+func (m *LockingMap[K, V]) LoadOrCompute(key K, compute func() V) (V, bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	if existing, exists := m.Underlying[key]; exists {
+		return existing, true
+	}
+	computed := compute()
+	m.Underlying[key] = computed
+	m.emitPut(key, computed, computed, false)
+	m.invalidateSnapshot()
+	return computed, false
+}
+
+// Swap stores the provided value for the key and returns the value it
+// replaced. The loaded result is true if a value was already present.
+func (m *LockingMap[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	previous, loaded = m.Underlying[key]
+	m.Underlying[key] = value
+	m.emitPut(key, previous, value, loaded)
+	m.invalidateSnapshot()
+	return previous, loaded
+}
+
+// LoadAndDelete removes the key from the map and returns the value it
+// held, if any. The second return value indicates whether the key was
+// present. The read and the delete happen under a single write lock, so
+// no other goroutine can observe or mutate the key in between.
+// RemoveAndGet is built on this and discards the presence flag.
+func (m *LockingMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	value, exists := m.Underlying[key]
+	delete(m.Underlying, key)
+	m.emitRemove(key, value, exists)
+	if exists {
+		m.invalidateSnapshot()
+	}
+	return value, exists
+}
@@ -0,0 +1,113 @@
+package main
+
+import "testing"
+
+func TestLockingMap_LoadOrStore(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test", 1)
+
+	value, loaded := m.LoadOrStore("Test", 2)
+	if !loaded || value != 1 {
+		t.Logf("Expected existing value 1 to be loaded, got %d (loaded=%v)", value, loaded)
+		t.FailNow()
+	}
+
+	value, loaded = m.LoadOrStore("Test2", 2)
+	if loaded || value != 2 {
+		t.Logf("Expected stored value 2, got %d (loaded=%v)", value, loaded)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_LoadOrCompute(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test", 1)
+
+	value, loaded := m.LoadOrCompute("Test", func() int { return 2 })
+	if !loaded || value != 1 {
+		t.Logf("Expected existing value 1 to be loaded, got %d (loaded=%v)", value, loaded)
+		t.FailNow()
+	}
+
+	value, loaded = m.LoadOrCompute("Test2", func() int { return 2 })
+	if loaded || value != 2 {
+		t.Logf("Expected computed value 2, got %d (loaded=%v)", value, loaded)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_Swap(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test", 1)
+
+	previous, loaded := m.Swap("Test", 2)
+	if !loaded || previous != 1 {
+		t.Logf("Expected previous value 1, got %d (loaded=%v)", previous, loaded)
+		t.FailNow()
+	}
+	if value, _ := m.Get("Test"); value != 2 {
+		t.Logf("Expected 'Test' to be swapped to 2, got %d", value)
+		t.FailNow()
+	}
+
+	previous, loaded = m.Swap("Test2", 5)
+	if loaded {
+		t.Log("Expected 'Test2' to not have had a previous value")
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_LoadAndDelete(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test", 1)
+
+	value, exists := m.LoadAndDelete("Test")
+	if !exists || value != 1 {
+		t.Logf("Expected deleted value 1, got %d (exists=%v)", value, exists)
+		t.FailNow()
+	}
+	if m.Contains("Test") {
+		t.Log("Expected 'Test' to be removed")
+		t.FailNow()
+	}
+}
+
+func TestLockingComparableMap_CompareAndSwap(t *testing.T) {
+	m := NewComparableMap[string, int]()
+	m.Put("Test", 1)
+
+	if m.CompareAndSwap("Test", 2, 3) {
+		t.Log("Expected swap against wrong old value to fail")
+		t.FailNow()
+	}
+	if !m.CompareAndSwap("Test", 1, 3) {
+		t.Log("Expected swap against correct old value to succeed")
+		t.FailNow()
+	}
+	if value, _ := m.Get("Test"); value != 3 {
+		t.Logf("Expected 'Test' to be 3, got %d", value)
+		t.FailNow()
+	}
+	if m.CompareAndSwap("Missing", 0, 1) {
+		t.Log("Expected swap against a missing key to fail")
+		t.FailNow()
+	}
+}
+
+func TestLockingComparableMap_CompareAndDelete(t *testing.T) {
+	m := NewComparableMap[string, int]()
+	m.Put("Test", 1)
+
+	if m.CompareAndDelete("Test", 2) {
+		t.Log("Expected delete against wrong old value to fail")
+		t.FailNow()
+	}
+	if !m.CompareAndDelete("Test", 1) {
+		t.Log("Expected delete against correct old value to succeed")
+		t.FailNow()
+	}
+	if m.Contains("Test") {
+		t.Log("Expected 'Test' to be removed")
+		t.FailNow()
+	}
+}
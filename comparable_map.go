@@ -0,0 +1,47 @@
+package lmap
+
+// LockingComparableMap is a LockingMap whose values support equality
+// comparison, enabling compare-and-swap style atomic operations that
+// aren't possible against an arbitrary V any. Everything else about it,
+// including the underlying lock and map, behaves exactly like LockingMap.
+type LockingComparableMap[K string | uint | int, V comparable] struct {
+	LockingMap[K, V]
+}
+
+// NewComparableMap constructor for creating a new locking map whose
+// values can be compared for equality
+func NewComparableMap[K string | uint | int, V comparable]() LockingComparableMap[K, V] {
+	return LockingComparableMap[K, V]{LockingMap: NewMap[K, V]()}
+}
+
+// CompareAndSwap stores new for key only if the current value is equal
+// to old, returning whether the swap took place. If the key isn't
+// present, the swap fails.
+func (m *LockingComparableMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	current, exists := m.Underlying[key]
+	if !exists || current != old {
+		return false
+	}
+	m.Underlying[key] = new
+	m.emitPut(key, current, new, true)
+	m.invalidateSnapshot()
+	return true
+}
+
+// CompareAndDelete removes key only if the current value is equal to
+// old, returning whether the delete took place. If the key isn't
+// present, the delete fails.
+func (m *LockingComparableMap[K, V]) CompareAndDelete(key K, old V) bool {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	current, exists := m.Underlying[key]
+	if !exists || current != old {
+		return false
+	}
+	delete(m.Underlying, key)
+	m.emitRemove(key, current, true)
+	m.invalidateSnapshot()
+	return true
+}
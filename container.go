@@ -0,0 +1,20 @@
+package lmap
+
+// Container is the common read surface shared by LockingMap and its
+// variants (OrderedLockingMap, ShardedLockingMap, ImmutableMap), letting
+// downstream code write generic helpers - equality, diffing, merging -
+// against the interface instead of a concrete struct.
+type Container[K string | uint | int, V any] interface {
+	Size() int
+	Contains(key K) bool
+	Get(key K) (V, bool)
+	ForEach(action func(key K, value V))
+	GetEntries() []Entry[K, V]
+}
+
+var (
+	_ Container[string, int] = (*LockingMap[string, int])(nil)
+	_ Container[string, int] = (*OrderedLockingMap[string, int])(nil)
+	_ Container[string, int] = (*ShardedLockingMap[string, int])(nil)
+	_ Container[string, int] = (*ImmutableMap[string, int])(nil)
+)
@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func sizeOfContainer[K string | uint | int, V any](c Container[K, V]) int {
+	return c.Size()
+}
+
+func TestContainer_LockingMap(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 5)
+	if sizeOfContainer[string, int](&m) != 5 {
+		t.Log("Expected LockingMap to satisfy Container via generic helper")
+		t.FailNow()
+	}
+}
+
+func TestContainer_OrderedLockingMap(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 5)
+	if sizeOfContainer[int, int](m) != 5 {
+		t.Log("Expected OrderedLockingMap to satisfy Container via generic helper")
+		t.FailNow()
+	}
+}
+
+func TestContainer_ShardedLockingMap(t *testing.T) {
+	m := NewShardedMap[string, int](4)
+	PutJunkDataSharded(m, 5)
+	if sizeOfContainer[string, int](m) != 5 {
+		t.Log("Expected ShardedLockingMap to satisfy Container via generic helper")
+		t.FailNow()
+	}
+}
+
+func TestContainer_ImmutableMap(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 5)
+	snapshot := m.Snapshot()
+	if sizeOfContainer[string, int](snapshot) != 5 {
+		t.Log("Expected ImmutableMap to satisfy Container via generic helper")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,158 @@
+package lmap
+
+import "sync"
+
+// EventType identifies the kind of mutation a Event describes.
+type EventType int
+
+const (
+	// EventPut fires when a new key is inserted into the map.
+	EventPut EventType = iota
+	// EventUpdate fires when an existing key's value is overwritten.
+	EventUpdate
+	// EventRemove fires when a key is deleted from the map.
+	EventRemove
+	// EventClear fires once per key removed by Clear/ClearAnd.
+	EventClear
+)
+
+// Event describes a single mutation of a LockingMap, delivered to
+// subscribers registered via Subscribe/SubscribeKey/SubscribeAsync.
+type Event[K string | uint | int, V any] struct {
+	Type     EventType
+	Key      K
+	OldValue V
+	NewValue V
+	// Version is a monotonically increasing counter, unique per map,
+	// that can be used to detect missed or reordered events.
+	Version uint64
+}
+
+// subscription is a single registered observer, either delivered
+// synchronously via handler or asynchronously via ch. closeLock guards
+// ch against the race between a concurrent send in emit and Close being
+// called by unsubscribe: both take closeLock before touching ch, so a
+// send can never land on a channel that's already been, or is being,
+// closed.
+type subscription[K string | uint | int, V any] struct {
+	key       K
+	hasKey    bool
+	handler   func(Event[K, V])
+	ch        chan Event[K, V]
+	closeLock sync.Mutex
+	closed    bool
+}
+
+// send delivers evt to the subscription's channel, dropping it if the
+// channel is full or the subscription has already been closed.
+func (s *subscription[K, V]) send(evt Event[K, V]) {
+	s.closeLock.Lock()
+	defer s.closeLock.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.ch <- evt:
+	default:
+		// Drop policy: subscriber isn't keeping up, skip this event
+	}
+}
+
+// close marks the subscription closed and closes its channel. Safe to
+// call concurrently with send: both are serialized through closeLock.
+func (s *subscription[K, V]) close() {
+	s.closeLock.Lock()
+	defer s.closeLock.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.ch)
+}
+
+// eventHub owns the subscriber bookkeeping for a LockingMap. It is
+// guarded by its own lock, independent of the map's data lock, though
+// emit is always called while the caller already holds the map's write
+// lock so that subscribers observe events in mutation order.
+type eventHub[K string | uint | int, V any] struct {
+	lock    sync.Mutex
+	nextID  uint64
+	version uint64
+	subs    map[uint64]*subscription[K, V]
+}
+
+func newEventHub[K string | uint | int, V any]() *eventHub[K, V] {
+	return &eventHub[K, V]{subs: make(map[uint64]*subscription[K, V])}
+}
+
+func (h *eventHub[K, V]) add(sub *subscription[K, V]) (unsubscribe func()) {
+	h.lock.Lock()
+	id := h.nextID
+	h.nextID++
+	h.subs[id] = sub
+	h.lock.Unlock()
+	return func() {
+		h.lock.Lock()
+		delete(h.subs, id)
+		h.lock.Unlock()
+	}
+}
+
+// emit delivers evt to every matching subscriber. Synchronous
+// subscribers run handler inline, so emit (and therefore the mutation
+// that triggered it) only returns once every synchronous handler has.
+// Asynchronous subscribers receive the event over a buffered channel; if
+// that channel is full the event is dropped for that subscriber rather
+// than blocking the writer.
+func (h *eventHub[K, V]) emit(evt Event[K, V]) {
+	h.lock.Lock()
+	evt.Version = h.version
+	h.version++
+	subs := make([]*subscription[K, V], 0, len(h.subs))
+	for _, sub := range h.subs {
+		if !sub.hasKey || sub.key == evt.Key {
+			subs = append(subs, sub)
+		}
+	}
+	h.lock.Unlock()
+
+	for _, sub := range subs {
+		if sub.ch != nil {
+			sub.send(evt)
+			continue
+		}
+		sub.handler(evt)
+	}
+}
+
+// Subscribe registers handler to be called, synchronously and under the
+// map's write lock, for every Put, PutAll, Remove, RemoveAndGet,
+// RemoveIf, RemoveUnless, Clear and ClearAnd. The returned function
+// removes the subscription; it is safe to call more than once. handler
+// must not call back into the map, or it will deadlock.
+func (m *LockingMap[K, V]) Subscribe(handler func(evt Event[K, V])) (unsubscribe func()) {
+	return m.events.add(&subscription[K, V]{handler: handler})
+}
+
+// SubscribeKey registers handler to be called, synchronously and under
+// the map's write lock, only for events concerning key. See Subscribe
+// for delivery and re-entrancy caveats.
+func (m *LockingMap[K, V]) SubscribeKey(key K, handler func(evt Event[K, V])) (unsubscribe func()) {
+	return m.events.add(&subscription[K, V]{key: key, hasKey: true, handler: handler})
+}
+
+// SubscribeAsync delivers events over the returned buffered channel
+// instead of calling a handler inline, so subscribers never hold the
+// map's write lock across their own processing. If the subscriber falls
+// behind, events are dropped once the buffer (sized by bufferSize) fills
+// rather than blocking writers. The returned unsubscribe function stops
+// delivery and closes the channel.
+func (m *LockingMap[K, V]) SubscribeAsync(bufferSize int) (events <-chan Event[K, V], unsubscribe func()) {
+	ch := make(chan Event[K, V], bufferSize)
+	sub := &subscription[K, V]{ch: ch}
+	remove := m.events.add(sub)
+	return ch, func() {
+		remove()
+		sub.close()
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLockingMap_Subscribe(t *testing.T) {
+	m := NewMap[string, int]()
+	var received []Event[string, int]
+	unsubscribe := m.Subscribe(func(evt Event[string, int]) {
+		received = append(received, evt)
+	})
+	defer unsubscribe()
+
+	m.Put("Test", 1)
+	m.Put("Test", 2)
+	m.Remove("Test")
+
+	if len(received) != 3 {
+		t.Logf("Expected 3 events but got %d", len(received))
+		t.FailNow()
+	}
+	if received[0].Type != EventPut {
+		t.Log("Expected first event to be EventPut")
+		t.FailNow()
+	}
+	if received[1].Type != EventUpdate || received[1].OldValue != 1 || received[1].NewValue != 2 {
+		t.Logf("Expected second event to be EventUpdate 1->2, got %+v", received[1])
+		t.FailNow()
+	}
+	if received[2].Type != EventRemove || received[2].OldValue != 2 {
+		t.Logf("Expected third event to be EventRemove with old value 2, got %+v", received[2])
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_SubscribeUnsubscribe(t *testing.T) {
+	m := NewMap[string, int]()
+	count := 0
+	unsubscribe := m.Subscribe(func(evt Event[string, int]) {
+		count++
+	})
+	m.Put("Test", 1)
+	unsubscribe()
+	m.Put("Test2", 2)
+
+	if count != 1 {
+		t.Logf("Expected 1 event to have been delivered before unsubscribing, got %d", count)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_SubscribeKey(t *testing.T) {
+	m := NewMap[string, int]()
+	var received []Event[string, int]
+	unsubscribe := m.SubscribeKey("Watched", func(evt Event[string, int]) {
+		received = append(received, evt)
+	})
+	defer unsubscribe()
+
+	m.Put("Ignored", 1)
+	m.Put("Watched", 2)
+
+	if len(received) != 1 || received[0].Key != "Watched" {
+		t.Logf("Expected exactly one event for 'Watched', got %+v", received)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_SubscribeAsync(t *testing.T) {
+	m := NewMap[string, int]()
+	events, unsubscribe := m.SubscribeAsync(4)
+	defer unsubscribe()
+
+	m.Put("Test", 1)
+
+	evt := <-events
+	if evt.Type != EventPut || evt.Key != "Test" {
+		t.Logf("Expected an EventPut for 'Test', got %+v", evt)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_SubscribeAsyncDropsWhenFull(t *testing.T) {
+	m := NewMap[string, int]()
+	events, unsubscribe := m.SubscribeAsync(1)
+	defer unsubscribe()
+
+	m.Put("Test1", 1)
+	m.Put("Test2", 2)
+
+	if len(events) != 1 {
+		t.Logf("Expected the buffered channel to hold exactly 1 event, got %d", len(events))
+		t.FailNow()
+	}
+}
+
+// TestLockingMap_SubscribeAsyncConcurrentUnsubscribe guards against a
+// send-on-closed-channel panic: a writer emitting events concurrently
+// with a subscriber unsubscribing must never crash, regardless of how
+// the two interleave.
+func TestLockingMap_SubscribeAsyncConcurrentUnsubscribe(t *testing.T) {
+	m := NewMap[string, int]()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			m.Put("Test", i)
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		events, unsubscribe := m.SubscribeAsync(1)
+		go func() {
+			for range events {
+			}
+		}()
+		unsubscribe()
+	}
+
+	wg.Wait()
+}
+
+// TestLockingMap_RemoveIf_NoDuplicateEventsUnderRace guards against a
+// TOCTOU where RemoveIf/RemoveUnless/Clear/ClearAnd snapshot entries
+// before taking the write lock: if a concurrent Remove deletes the key
+// first, the snapshot-based delete is a no-op and must not also fire an
+// EventRemove for a key that's no longer actually being removed.
+func TestLockingMap_RemoveIf_NoDuplicateEventsUnderRace(t *testing.T) {
+	m := NewMap[string, int]()
+	var removeCount int64
+	unsubscribe := m.Subscribe(func(evt Event[string, int]) {
+		if evt.Type == EventRemove {
+			atomic.AddInt64(&removeCount, 1)
+		}
+	})
+	defer unsubscribe()
+
+	const iterations = 2000
+	for i := 0; i < iterations; i++ {
+		m.Put("X", i)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			m.Remove("X")
+		}()
+		go func() {
+			defer wg.Done()
+			m.RemoveIf(func(key string, value int) bool {
+				return true
+			})
+		}()
+		wg.Wait()
+	}
+
+	if removeCount != int64(iterations) {
+		t.Logf("Expected exactly %d remove events (one per actual removal) but got %d", iterations, removeCount)
+		t.FailNow()
+	}
+}
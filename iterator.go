@@ -0,0 +1,61 @@
+package lmap
+
+// Range iterates over all the key values in the underlying map, matching
+// the convention used by sync.Map: action is called for every entry and
+// iteration stops early the first time it returns false. This gives
+// callers a single canonical stoppable iteration method instead of
+// choosing between ForEach, ForEachUntil and ForEachSafe.
+func (m *LockingMap[K, V]) Range(action func(key K, value V) bool) {
+	m.Lock.RLock()
+	for k, v := range m.Underlying {
+		if !action(k, v) {
+			break
+		}
+	}
+	m.Lock.RUnlock()
+}
+
+// Iterator is a pull-style iterator over a snapshot of a LockingMap's
+// entries, obtained via LockingMap.Iter. Unlike ForEach/Range, the caller
+// drives iteration by calling Next, so arbitrary user code (including
+// code that blocks or calls back into the map) can run between steps
+// without holding any lock.
+type Iterator[K string | uint | int, V any] struct {
+	entries []Entry[K, V]
+	pos     int
+	stopped bool
+}
+
+// Iter snapshots the map's current entries under a brief read lock and
+// returns an Iterator that can be pulled through lazily afterwards.
+func (m *LockingMap[K, V]) Iter() *Iterator[K, V] {
+	return &Iterator[K, V]{entries: m.GetEntries(), pos: -1}
+}
+
+// Next advances the iterator to the next entry, returning false once the
+// iterator is exhausted or has been stopped.
+func (it *Iterator[K, V]) Next() bool {
+	if it.stopped || it.pos+1 >= len(it.entries) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+// Key returns the key at the iterator's current position. Only valid
+// after a call to Next that returned true.
+func (it *Iterator[K, V]) Key() K {
+	return it.entries[it.pos].Key
+}
+
+// Value returns the value at the iterator's current position. Only
+// valid after a call to Next that returned true.
+func (it *Iterator[K, V]) Value() V {
+	return it.entries[it.pos].Value
+}
+
+// Stop ends the iterator early; subsequent calls to Next will return
+// false. Safe to call more than once.
+func (it *Iterator[K, V]) Stop() {
+	it.stopped = true
+}
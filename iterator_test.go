@@ -0,0 +1,108 @@
+package main
+
+import "testing"
+
+func TestLockingMap_Range(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 20)
+	i := 0
+	m.Range(func(key string, value int) bool {
+		i++
+		return true
+	})
+	if i != 20 {
+		t.Logf("Expected iteration of 20 elements but only iterated %d times", i)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_RangeStopsEarly(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 20)
+	i := 0
+	m.Range(func(key string, value int) bool {
+		i++
+		return i < 5
+	})
+	if i != 5 {
+		t.Logf("Expected iteration to stop after 5 elements but got %d", i)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_Iter(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 10)
+
+	it := m.Iter()
+	count := 0
+	for it.Next() {
+		_ = it.Key()
+		_ = it.Value()
+		count++
+	}
+	if count != 10 {
+		t.Logf("Expected to iterate 10 entries but got %d", count)
+		t.FailNow()
+	}
+	if it.Next() {
+		t.Log("Expected exhausted iterator to keep returning false")
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_IterStop(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 10)
+
+	it := m.Iter()
+	count := 0
+	for it.Next() {
+		count++
+		if count == 3 {
+			it.Stop()
+		}
+	}
+	if count != 3 {
+		t.Logf("Expected iteration to stop after 3 entries but got %d", count)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_GetValuePointersDistinct(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 5)
+
+	pointers := m.GetValuePointers()
+	seen := make(map[int]bool)
+	for _, p := range pointers {
+		if seen[*p] {
+			t.Log("Expected each value pointer to refer to a distinct value")
+			t.FailNow()
+		}
+		seen[*p] = true
+	}
+	if len(seen) != 5 {
+		t.Logf("Expected 5 distinct values but got %d", len(seen))
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_GetEntryPointersDistinct(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 5)
+
+	entries := m.GetEntryPointers()
+	seen := make(map[int]bool)
+	for _, e := range entries {
+		if seen[*e.Value] {
+			t.Log("Expected each entry pointer to refer to a distinct value")
+			t.FailNow()
+		}
+		seen[*e.Value] = true
+	}
+	if len(seen) != 5 {
+		t.Logf("Expected 5 distinct values but got %d", len(seen))
+		t.FailNow()
+	}
+}
@@ -2,12 +2,21 @@ package lmap
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 // LockingMap The structure of a locking map
 type LockingMap[K string | uint | int, V any] struct {
 	Lock       *sync.RWMutex
 	Underlying map[K]V
+	events     *eventHub[K, V]
+	// snap caches the last copy Snapshot took; dirty marks that
+	// Underlying has changed since. Mutators just flip dirty (O(1));
+	// Snapshot does the O(n) copy lazily, only when dirty, so Put/Remove
+	// stay O(1) amortized instead of paying a copy on every call. Both
+	// are pointers, like Lock, so LockingMap itself stays copyable.
+	snap  *atomic.Pointer[map[K]V]
+	dirty *atomic.Bool
 }
 
 // Entry represents a key value pair for the map
@@ -22,6 +31,9 @@ func NewMap[K string | uint | int, V any | *any]() LockingMap[K, V] {
 	out := LockingMap[K, V]{
 		Lock:       &sync.RWMutex{},
 		Underlying: m,
+		events:     newEventHub[K, V](),
+		snap:       &atomic.Pointer[map[K]V]{},
+		dirty:      &atomic.Bool{},
 	}
 	return out
 }
@@ -33,6 +45,9 @@ func NewMapOf[K string | uint | int, V any](entries []Entry[K, V]) LockingMap[K,
 	out := LockingMap[K, V]{
 		Lock:       &sync.RWMutex{},
 		Underlying: m,
+		events:     newEventHub[K, V](),
+		snap:       &atomic.Pointer[map[K]V]{},
+		dirty:      &atomic.Bool{},
 	}
 	for _, entry := range entries {
 		m[entry.Key] = entry.Value
@@ -51,6 +66,9 @@ func NewMapOfArrays[K string | uint | int, V any](keys []K, values []V) LockingM
 	out := LockingMap[K, V]{
 		Lock:       &sync.RWMutex{},
 		Underlying: m,
+		events:     newEventHub[K, V](),
+		snap:       &atomic.Pointer[map[K]V]{},
+		dirty:      &atomic.Bool{},
 	}
 	for i, key := range keys {
 		value := values[i]
@@ -132,22 +150,50 @@ func (m *LockingMap[K, V]) Size() int {
 	return l
 }
 
+// invalidateSnapshot marks the cached snapshot stale so the next
+// Snapshot call recomputes it. Must be called after mutating Underlying
+// while the write lock is still held. O(1): unlike copying the map on
+// every mutation, this keeps Put/Remove/etc. O(1) amortized; the O(n)
+// copy only happens lazily, inside Snapshot, and only when something
+// has actually changed since the last one was taken.
+func (m *LockingMap[K, V]) invalidateSnapshot() {
+	m.dirty.Store(true)
+}
+
 // PutAll inserts all the provided entries into the map
 func (m *LockingMap[K, V]) PutAll(entries []Entry[K, V]) {
 	m.Lock.Lock()
 	for _, entry := range entries {
+		old, existed := m.Underlying[entry.Key]
 		m.Underlying[entry.Key] = entry.Value
+		m.emitPut(entry.Key, old, entry.Value, existed)
 	}
+	m.invalidateSnapshot()
 	m.Lock.Unlock()
 }
 
 // Put inserts the key value pair into the map.
 func (m *LockingMap[K, V]) Put(key K, value V) {
 	m.Lock.Lock()
+	old, existed := m.Underlying[key]
 	m.Underlying[key] = value
+	m.emitPut(key, old, value, existed)
+	m.invalidateSnapshot()
 	m.Lock.Unlock()
 }
 
+// emitPut fires an EventPut or EventUpdate as appropriate. Must be
+// called with the write lock already held.
+func (m *LockingMap[K, V]) emitPut(key K, old, new V, existed bool) {
+	evt := Event[K, V]{Key: key, OldValue: old, NewValue: new}
+	if existed {
+		evt.Type = EventUpdate
+	} else {
+		evt.Type = EventPut
+	}
+	m.events.emit(evt)
+}
+
 // Contains Returns whether the map contains the provided key
 func (m *LockingMap[K, V]) Contains(key K) bool {
 	m.Lock.RLock()
@@ -207,20 +253,31 @@ func (m *LockingMap[K, V]) GetOrCompute(key K, compute func() V) V {
 	}
 }
 
+// emitRemove fires an EventRemove if the key was actually present. Must
+// be called with the write lock already held.
+func (m *LockingMap[K, V]) emitRemove(key K, old V, existed bool) {
+	if !existed {
+		return
+	}
+	m.events.emit(Event[K, V]{Type: EventRemove, Key: key, OldValue: old})
+}
+
 // Remove safely removes the key from the underlying map.
 func (m *LockingMap[K, V]) Remove(key K) {
 	m.Lock.Lock()
+	old, existed := m.Underlying[key]
 	delete(m.Underlying, key)
+	m.emitRemove(key, old, existed)
+	if existed {
+		m.invalidateSnapshot()
+	}
 	m.Lock.Unlock()
 }
 
 // RemoveAndGet safely removes the key from the underlying map. And
 // returns the value that existed or nil
 func (m *LockingMap[K, V]) RemoveAndGet(key K) V {
-	value, _ := m.Get(key)
-	m.Lock.Lock()
-	delete(m.Underlying, key)
-	m.Lock.Unlock()
+	value, _ := m.LoadAndDelete(key)
 	return value
 }
 
@@ -229,11 +286,19 @@ func (m *LockingMap[K, V]) RemoveAndGet(key K) V {
 func (m *LockingMap[K, V]) RemoveIf(action func(key K, value V) bool) {
 	values := m.GetEntries()
 	m.Lock.Lock()
+	removed := false
 	for _, entry := range values {
 		if action(entry.Key, entry.Value) {
-			delete(m.Underlying, entry.Key)
+			if old, existed := m.Underlying[entry.Key]; existed {
+				delete(m.Underlying, entry.Key)
+				m.emitRemove(entry.Key, old, true)
+				removed = true
+			}
 		}
 	}
+	if removed {
+		m.invalidateSnapshot()
+	}
 	m.Lock.Unlock()
 }
 
@@ -242,20 +307,36 @@ func (m *LockingMap[K, V]) RemoveIf(action func(key K, value V) bool) {
 func (m *LockingMap[K, V]) RemoveUnless(action func(key K, value V) bool) {
 	values := m.GetEntries()
 	m.Lock.Lock()
+	removed := false
 	for _, entry := range values {
 		if !action(entry.Key, entry.Value) {
-			delete(m.Underlying, entry.Key)
+			if old, existed := m.Underlying[entry.Key]; existed {
+				delete(m.Underlying, entry.Key)
+				m.emitRemove(entry.Key, old, true)
+				removed = true
+			}
 		}
 	}
+	if removed {
+		m.invalidateSnapshot()
+	}
 	m.Lock.Unlock()
 }
 
 // Clear removes all keys and values from the underlying map.
 func (m *LockingMap[K, V]) Clear() {
-	keys := m.GetKeys()
+	entries := m.GetEntries()
 	m.Lock.Lock()
-	for _, k := range keys {
-		delete(m.Underlying, k)
+	removed := false
+	for _, entry := range entries {
+		if old, existed := m.Underlying[entry.Key]; existed {
+			delete(m.Underlying, entry.Key)
+			m.events.emit(Event[K, V]{Type: EventClear, Key: entry.Key, OldValue: old})
+			removed = true
+		}
+	}
+	if removed {
+		m.invalidateSnapshot()
 	}
 	m.Lock.Unlock()
 }
@@ -265,9 +346,17 @@ func (m *LockingMap[K, V]) Clear() {
 func (m *LockingMap[K, V]) ClearAnd(action func(key K, value V)) {
 	values := m.GetEntries()
 	m.Lock.Lock()
+	removed := false
 	for _, entry := range values {
 		action(entry.Key, entry.Value)
-		delete(m.Underlying, entry.Key)
+		if old, existed := m.Underlying[entry.Key]; existed {
+			delete(m.Underlying, entry.Key)
+			m.events.emit(Event[K, V]{Type: EventClear, Key: entry.Key, OldValue: old})
+			removed = true
+		}
+	}
+	if removed {
+		m.invalidateSnapshot()
 	}
 	m.Lock.Unlock()
 }
@@ -315,13 +404,17 @@ func (m *LockingMap[K, V]) MinOf(action func(key K, value V) int) int {
 }
 
 // GetValuePointers creates an array with pointers to all the values stored
-// inside the locking map.
+// inside the locking map. Each pointer refers to its own copy, backed by
+// a stable slice allocated up front, so they remain distinct once this
+// function returns.
 func (m *LockingMap[K, V]) GetValuePointers() []*V {
 	m.Lock.RLock()
-	out := make([]*V, m.Size())
+	values := make([]V, len(m.Underlying))
+	out := make([]*V, len(values))
 	i := 0
 	for _, v := range m.Underlying {
-		out[i] = &v
+		values[i] = v
+		out[i] = &values[i]
 		i++
 	}
 	m.Lock.RUnlock()
@@ -332,7 +425,7 @@ func (m *LockingMap[K, V]) GetValuePointers() []*V {
 // locking map.
 func (m *LockingMap[K, V]) GetValues() []V {
 	m.Lock.RLock()
-	out := make([]V, m.Size())
+	out := make([]V, len(m.Underlying))
 	i := 0
 	for _, v := range m.Underlying {
 		out[i] = v
@@ -346,7 +439,7 @@ func (m *LockingMap[K, V]) GetValues() []V {
 // locking map.
 func (m *LockingMap[K, V]) GetKeys() []K {
 	m.Lock.RLock()
-	out := make([]K, m.Size())
+	out := make([]K, len(m.Underlying))
 	i := 0
 	for k := range m.Underlying {
 		out[i] = k
@@ -360,7 +453,7 @@ func (m *LockingMap[K, V]) GetKeys() []K {
 // locking map.
 func (m *LockingMap[K, V]) GetEntries() []Entry[K, V] {
 	m.Lock.RLock()
-	out := make([]Entry[K, V], m.Size())
+	out := make([]Entry[K, V], len(m.Underlying))
 	i := 0
 	for k, v := range m.Underlying {
 		out[i] = Entry[K, V]{Key: k, Value: v}
@@ -371,13 +464,17 @@ func (m *LockingMap[K, V]) GetEntries() []Entry[K, V] {
 }
 
 // GetEntryPointers creates an array with all the key and value pointers stored inside the
-// locking map.
+// locking map. Each value pointer refers to its own copy, backed by a
+// stable slice allocated up front, so they remain distinct once this
+// function returns.
 func (m *LockingMap[K, V]) GetEntryPointers() []Entry[K, *V] {
 	m.Lock.RLock()
-	out := make([]Entry[K, *V], m.Size())
+	values := make([]V, len(m.Underlying))
+	out := make([]Entry[K, *V], len(values))
 	i := 0
 	for k, v := range m.Underlying {
-		out[i] = Entry[K, *V]{Key: k, Value: &v}
+		values[i] = v
+		out[i] = Entry[K, *V]{Key: k, Value: &values[i]}
 		i++
 	}
 	m.Lock.RUnlock()
@@ -0,0 +1,156 @@
+package lmap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// isStringKeyed reports whether K is instantiated as string, which
+// determines the JSON encoding used by MarshalJSON/UnmarshalJSON.
+func isStringKeyed[K string | uint | int]() bool {
+	var zero K
+	_, ok := any(zero).(string)
+	return ok
+}
+
+// MarshalJSON encodes the map's current contents as a stable snapshot
+// taken under the read lock. When K is string, the result is a regular
+// JSON object keyed by those strings; otherwise it's a JSON array of
+// {"Key": ..., "Value": ...} entries, since JSON object keys must be
+// strings.
+func (m *LockingMap[K, V]) MarshalJSON() ([]byte, error) {
+	m.ensureInitialized()
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+
+	if isStringKeyed[K]() {
+		obj := make(map[string]V, len(m.Underlying))
+		for k, v := range m.Underlying {
+			obj[any(k).(string)] = v
+		}
+		return json.Marshal(obj)
+	}
+
+	entries := make([]Entry[K, V], 0, len(m.Underlying))
+	for k, v := range m.Underlying {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON replaces the map's contents with the entries decoded
+// from data, using the same encoding MarshalJSON produces. m may be the
+// zero value (e.g. when decoding into a struct field that was never
+// passed through NewMap); its lock and event hub are lazily initialized.
+func (m *LockingMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.ensureInitialized()
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	if isStringKeyed[K]() {
+		var obj map[string]V
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+		underlying := make(map[K]V, len(obj))
+		for k, v := range obj {
+			key, ok := any(k).(K)
+			if !ok {
+				return fmt.Errorf("lmap: cannot decode JSON key %q into key type", k)
+			}
+			underlying[key] = v
+		}
+		m.Underlying = underlying
+		m.invalidateSnapshot()
+		return nil
+	}
+
+	var entries []Entry[K, V]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	underlying := make(map[K]V, len(entries))
+	for _, entry := range entries {
+		underlying[entry.Key] = entry.Value
+	}
+	m.Underlying = underlying
+	m.invalidateSnapshot()
+	return nil
+}
+
+// GobEncode encodes the map's current contents, taken as a snapshot
+// under the read lock, for binary transport via encoding/gob.
+func (m *LockingMap[K, V]) GobEncode() ([]byte, error) {
+	m.ensureInitialized()
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+
+	entries := make([]Entry[K, V], 0, len(m.Underlying))
+	for k, v := range m.Underlying {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(entries); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the map's contents with the entries decoded from
+// data, as produced by GobEncode. m may be the zero value; its lock and
+// event hub are lazily initialized.
+func (m *LockingMap[K, V]) GobDecode(data []byte) error {
+	var entries []Entry[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return err
+	}
+
+	m.ensureInitialized()
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	underlying := make(map[K]V, len(entries))
+	for _, entry := range entries {
+		underlying[entry.Key] = entry.Value
+	}
+	m.Underlying = underlying
+	m.invalidateSnapshot()
+	return nil
+}
+
+// zeroValueInitLock guards ensureInitialized's lazy init below. It can't
+// use the LockingMap's own m.Lock to guard the creation of that very
+// field, so initialization of any zero-value LockingMap is serialized
+// through this single package-wide mutex instead. The critical section
+// only runs for maps that were never passed through a constructor, so
+// the shared contention is negligible in practice.
+var zeroValueInitLock sync.Mutex
+
+// ensureInitialized lazily initializes a zero-value LockingMap's lock
+// and event hub, so decoding into a LockingMap that was never passed
+// through NewMap (e.g. a struct field left at its zero value) doesn't
+// panic on a nil lock. Safe to call concurrently.
+func (m *LockingMap[K, V]) ensureInitialized() {
+	if m.Lock != nil {
+		return
+	}
+	zeroValueInitLock.Lock()
+	defer zeroValueInitLock.Unlock()
+	if m.Lock == nil {
+		m.Lock = &sync.RWMutex{}
+	}
+	if m.events == nil {
+		m.events = newEventHub[K, V]()
+	}
+	if m.snap == nil {
+		m.snap = &atomic.Pointer[map[K]V]{}
+	}
+	if m.dirty == nil {
+		m.dirty = &atomic.Bool{}
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func TestLockingMap_MarshalJSON_StringKeyed(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test1", 1)
+	m.Put("Test2", 2)
+
+	data, err := json.Marshal(&m)
+	if err != nil {
+		t.Logf("Unexpected error marshaling map: %v", err)
+		t.FailNow()
+	}
+
+	var decoded map[string]int
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Logf("Expected string-keyed map to marshal as a JSON object: %v", err)
+		t.FailNow()
+	}
+	if decoded["Test1"] != 1 || decoded["Test2"] != 2 {
+		t.Log("Decoded JSON object did not match expected contents", decoded)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_UnmarshalJSON_StringKeyed(t *testing.T) {
+	data := []byte(`{"Test1":1,"Test2":2}`)
+
+	m := NewMap[string, int]()
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Logf("Unexpected error unmarshaling map: %v", err)
+		t.FailNow()
+	}
+	if m.Size() != 2 {
+		t.Logf("Expected 2 entries but got %d", m.Size())
+		t.FailNow()
+	}
+	if value, _ := m.Get("Test1"); value != 1 {
+		t.Logf("Expected 'Test1' to be 1 but got %d", value)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_MarshalJSON_IntKeyed(t *testing.T) {
+	m := NewMap[int, string]()
+	m.Put(1, "one")
+
+	data, err := json.Marshal(&m)
+	if err != nil {
+		t.Logf("Unexpected error marshaling map: %v", err)
+		t.FailNow()
+	}
+
+	var decoded []Entry[int, string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Logf("Expected int-keyed map to marshal as a JSON array of entries: %v", err)
+		t.FailNow()
+	}
+	if len(decoded) != 1 || decoded[0].Key != 1 || decoded[0].Value != "one" {
+		t.Log("Decoded JSON array did not match expected contents", decoded)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_JSONRoundTrip_IntKeyed(t *testing.T) {
+	m := NewMap[int, string]()
+	m.Put(1, "one")
+	m.Put(2, "two")
+
+	data, err := json.Marshal(&m)
+	if err != nil {
+		t.Logf("Unexpected error marshaling map: %v", err)
+		t.FailNow()
+	}
+
+	restored := NewMap[int, string]()
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Logf("Unexpected error unmarshaling map: %v", err)
+		t.FailNow()
+	}
+	if restored.Size() != 2 {
+		t.Logf("Expected 2 entries after round trip but got %d", restored.Size())
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_GobRoundTrip(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test1", 1)
+	m.Put("Test2", 2)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&m); err != nil {
+		t.Logf("Unexpected error gob encoding map: %v", err)
+		t.FailNow()
+	}
+
+	restored := NewMap[string, int]()
+	if err := gob.NewDecoder(&buf).Decode(&restored); err != nil {
+		t.Logf("Unexpected error gob decoding map: %v", err)
+		t.FailNow()
+	}
+	if restored.Size() != 2 {
+		t.Logf("Expected 2 entries after gob round trip but got %d", restored.Size())
+		t.FailNow()
+	}
+	if value, _ := restored.Get("Test2"); value != 2 {
+		t.Logf("Expected 'Test2' to be 2 but got %d", value)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_GobDecodeZeroValue(t *testing.T) {
+	m := NewMap[string, int]()
+	m.Put("Test", 1)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&m); err != nil {
+		t.Logf("Unexpected error gob encoding map: %v", err)
+		t.FailNow()
+	}
+
+	var restored LockingMap[string, int]
+	if err := gob.NewDecoder(&buf).Decode(&restored); err != nil {
+		t.Logf("Unexpected error gob decoding into zero-value map: %v", err)
+		t.FailNow()
+	}
+	if !restored.Contains("Test") {
+		t.Log("Expected decoded zero-value map to contain 'Test'")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,702 @@
+package lmap
+
+import (
+	"sync"
+)
+
+// avlNode is a single node of the AVL tree backing an OrderedLockingMap
+type avlNode[K string | uint | int, V any] struct {
+	key    K
+	value  V
+	height int
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+}
+
+// OrderedLockingMap is a LockingMap-like structure backed by an AVL tree
+// instead of a Go map, keeping its entries sorted by key. This makes it
+// suitable for time-series buckets, leaderboards and pagination, where
+// ordered iteration and range queries matter. All operations are guarded
+// by the same single sync.RWMutex discipline LockingMap uses.
+type OrderedLockingMap[K string | uint | int, V any] struct {
+	Lock *sync.RWMutex
+	root *avlNode[K, V]
+	size int
+}
+
+// NewOrderedMap constructor for creating a new ordered locking map
+func NewOrderedMap[K string | uint | int, V any]() *OrderedLockingMap[K, V] {
+	return &OrderedLockingMap[K, V]{Lock: &sync.RWMutex{}}
+}
+
+func nodeHeight[K string | uint | int, V any](n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func balanceFactor[K string | uint | int, V any](n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return nodeHeight(n.left) - nodeHeight(n.right)
+}
+
+func updateHeight[K string | uint | int, V any](n *avlNode[K, V]) {
+	left, right := nodeHeight(n.left), nodeHeight(n.right)
+	if left > right {
+		n.height = left + 1
+	} else {
+		n.height = right + 1
+	}
+}
+
+func rotateRight[K string | uint | int, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	updateHeight(n)
+	updateHeight(pivot)
+	return pivot
+}
+
+func rotateLeft[K string | uint | int, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	updateHeight(n)
+	updateHeight(pivot)
+	return pivot
+}
+
+func rebalance[K string | uint | int, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	updateHeight(n)
+	switch bf := balanceFactor(n); {
+	case bf > 1:
+		if balanceFactor(n.left) < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if balanceFactor(n.right) > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+// avlInsert inserts or overwrites key/value into the tree rooted at n,
+// reporting via inserted whether a new node was created
+func avlInsert[K string | uint | int, V any](n *avlNode[K, V], key K, value V) (_ *avlNode[K, V], inserted bool) {
+	if n == nil {
+		return &avlNode[K, V]{key: key, value: value, height: 1}, true
+	}
+	switch {
+	case key < n.key:
+		n.left, inserted = avlInsert(n.left, key, value)
+	case key > n.key:
+		n.right, inserted = avlInsert(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return rebalance(n), inserted
+}
+
+func avlMin[K string | uint | int, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// avlRemove removes key from the tree rooted at n, reporting via removed
+// whether a node was actually deleted
+func avlRemove[K string | uint | int, V any](n *avlNode[K, V], key K) (_ *avlNode[K, V], removed bool) {
+	if n == nil {
+		return nil, false
+	}
+	switch {
+	case key < n.key:
+		n.left, removed = avlRemove(n.left, key)
+	case key > n.key:
+		n.right, removed = avlRemove(n.right, key)
+	default:
+		removed = true
+		switch {
+		case n.left == nil:
+			return n.right, true
+		case n.right == nil:
+			return n.left, true
+		default:
+			successor := avlMin(n.right)
+			n.key = successor.key
+			n.value = successor.value
+			n.right, _ = avlRemove(n.right, successor.key)
+		}
+	}
+	if n == nil {
+		return nil, removed
+	}
+	return rebalance(n), removed
+}
+
+func avlFind[K string | uint | int, V any](n *avlNode[K, V], key K) *avlNode[K, V] {
+	for n != nil {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// avlInOrder walks the tree in ascending key order, stopping early if
+// action returns false
+func avlInOrder[K string | uint | int, V any](n *avlNode[K, V], action func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if !avlInOrder(n.left, action) {
+		return false
+	}
+	if !action(n.key, n.value) {
+		return false
+	}
+	return avlInOrder(n.right, action)
+}
+
+// avlRangeInOrder walks the tree rooted at n in ascending key order,
+// visiting only keys within [lo, hi] (or (lo, hi) when !inclusive).
+// Subtrees that fall entirely outside the range are pruned rather than
+// walked, so a call only costs O(log n + k) instead of the O(n) a full
+// avlInOrder plus filter would. Stops early if action returns false.
+func avlRangeInOrder[K string | uint | int, V any](n *avlNode[K, V], lo, hi K, inclusive bool, action func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.key < lo {
+		return avlRangeInOrder(n.right, lo, hi, inclusive, action)
+	}
+	if n.key > hi {
+		return avlRangeInOrder(n.left, lo, hi, inclusive, action)
+	}
+	if !avlRangeInOrder(n.left, lo, hi, inclusive, action) {
+		return false
+	}
+	if inclusive || (n.key != lo && n.key != hi) {
+		if !action(n.key, n.value) {
+			return false
+		}
+	}
+	return avlRangeInOrder(n.right, lo, hi, inclusive, action)
+}
+
+// Put inserts the key value pair into the map.
+func (m *OrderedLockingMap[K, V]) Put(key K, value V) {
+	m.Lock.Lock()
+	var inserted bool
+	m.root, inserted = avlInsert(m.root, key, value)
+	if inserted {
+		m.size++
+	}
+	m.Lock.Unlock()
+}
+
+// PutAll inserts all the provided entries into the map
+func (m *OrderedLockingMap[K, V]) PutAll(entries []Entry[K, V]) {
+	for _, entry := range entries {
+		m.Put(entry.Key, entry.Value)
+	}
+}
+
+// Get retrieves the value from the map using its key. The second
+// return value indicates whether a value is present
+func (m *OrderedLockingMap[K, V]) Get(key K) (V, bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	if n := avlFind(m.root, key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// GetPointer retrieves the value from the map using its key. Will
+// return a pointer to the value or nil if it doesn't exist
+func (m *OrderedLockingMap[K, V]) GetPointer(key K) *V {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	if n := avlFind(m.root, key); n != nil {
+		value := n.value
+		return &value
+	}
+	return nil
+}
+
+// GetOrDefault retrieves the value from the map using its key. Returns
+// the value provided as d if the key doesn't exist
+func (m *OrderedLockingMap[K, V]) GetOrDefault(key K, d V) V {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	if n := avlFind(m.root, key); n != nil {
+		return n.value
+	}
+	return d
+}
+
+// GetOrCompute retrieves the value from the map using its key. If
+// the provided key doesn't exist then the compute function will be
+// called and that will be inserted into the map
+func (m *OrderedLockingMap[K, V]) GetOrCompute(key K, compute func() V) V {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	if n := avlFind(m.root, key); n != nil {
+		return n.value
+	}
+	computed := compute()
+	var inserted bool
+	m.root, inserted = avlInsert(m.root, key, computed)
+	if inserted {
+		m.size++
+	}
+	return computed
+}
+
+// Contains returns whether the map contains the provided key
+func (m *OrderedLockingMap[K, V]) Contains(key K) bool {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	return avlFind(m.root, key) != nil
+}
+
+// Remove safely removes the key from the underlying tree.
+func (m *OrderedLockingMap[K, V]) Remove(key K) {
+	m.Lock.Lock()
+	var removed bool
+	m.root, removed = avlRemove(m.root, key)
+	if removed {
+		m.size--
+	}
+	m.Lock.Unlock()
+}
+
+// RemoveAndGet safely removes the key from the underlying tree. And
+// returns the value that existed or the zero value of V if it didn't
+func (m *OrderedLockingMap[K, V]) RemoveAndGet(key K) V {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	n := avlFind(m.root, key)
+	var removed bool
+	m.root, removed = avlRemove(m.root, key)
+	if removed {
+		m.size--
+	}
+	if n != nil {
+		return n.value
+	}
+	var zero V
+	return zero
+}
+
+// RemoveIf runs the provided action on all the entries in the map in
+// ascending key order; any calls that return true are deleted from the
+// underlying tree.
+func (m *OrderedLockingMap[K, V]) RemoveIf(action func(key K, value V) bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	var toRemove []K
+	avlInOrder(m.root, func(key K, value V) bool {
+		if action(key, value) {
+			toRemove = append(toRemove, key)
+		}
+		return true
+	})
+	for _, key := range toRemove {
+		var removed bool
+		m.root, removed = avlRemove(m.root, key)
+		if removed {
+			m.size--
+		}
+	}
+}
+
+// RemoveUnless runs the provided action on all the entries in the map in
+// ascending key order; any calls that return false are deleted from the
+// underlying tree.
+func (m *OrderedLockingMap[K, V]) RemoveUnless(action func(key K, value V) bool) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	var toRemove []K
+	avlInOrder(m.root, func(key K, value V) bool {
+		if !action(key, value) {
+			toRemove = append(toRemove, key)
+		}
+		return true
+	})
+	for _, key := range toRemove {
+		var removed bool
+		m.root, removed = avlRemove(m.root, key)
+		if removed {
+			m.size--
+		}
+	}
+}
+
+// Clear removes all keys and values from the underlying tree.
+func (m *OrderedLockingMap[K, V]) Clear() {
+	m.Lock.Lock()
+	m.root = nil
+	m.size = 0
+	m.Lock.Unlock()
+}
+
+// ClearAnd clears all entries from the map, in ascending key order, and
+// runs the provided action function on all the removed entries
+func (m *OrderedLockingMap[K, V]) ClearAnd(action func(key K, value V)) {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	avlInOrder(m.root, func(key K, value V) bool {
+		action(key, value)
+		return true
+	})
+	m.root = nil
+	m.size = 0
+}
+
+// Size returns the number of entries in the map
+func (m *OrderedLockingMap[K, V]) Size() int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	return m.size
+}
+
+// ForEach iterates over all the key values in the map in ascending key
+// order and runs the action function for each of them. Note: DO NOT
+// MODIFY THE MAP WITHIN THIS FUNCTION, the tree is held under its read
+// lock for the duration of the call
+func (m *OrderedLockingMap[K, V]) ForEach(action func(key K, value V)) {
+	m.Lock.RLock()
+	avlInOrder(m.root, func(key K, value V) bool {
+		action(key, value)
+		return true
+	})
+	m.Lock.RUnlock()
+}
+
+// ForEachSafe iterates over all the key values in the map, in ascending
+// key order, and runs the action function for each of them. This
+// function is safe for map write operations because it copies the
+// entries before iterating
+func (m *OrderedLockingMap[K, V]) ForEachSafe(action func(key K, value V)) {
+	entries := m.GetEntries()
+	for _, entry := range entries {
+		action(entry.Key, entry.Value)
+	}
+}
+
+// ForEachUntil iterates over all the key values in the map, in ascending
+// key order, and runs the action function for each of them until the
+// action function returns true
+func (m *OrderedLockingMap[K, V]) ForEachUntil(action func(key K, value V) bool) {
+	m.Lock.RLock()
+	avlInOrder(m.root, func(key K, value V) bool {
+		return !action(key, value)
+	})
+	m.Lock.RUnlock()
+}
+
+// AnyMatch returns whether any of the entries in the map match the
+// provided test function condition
+func (m *OrderedLockingMap[K, V]) AnyMatch(test func(key K, value V) bool) bool {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	found := false
+	avlInOrder(m.root, func(key K, value V) bool {
+		if test(key, value) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// AllMatch returns whether all the entries in the map match the
+// provided test function condition
+func (m *OrderedLockingMap[K, V]) AllMatch(test func(key K, value V) bool) bool {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	all := true
+	avlInOrder(m.root, func(key K, value V) bool {
+		if !test(key, value) {
+			all = false
+			return false
+		}
+		return true
+	})
+	return all
+}
+
+// GetKeys creates an array with all the keys stored inside the map,
+// sorted in ascending order.
+func (m *OrderedLockingMap[K, V]) GetKeys() []K {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	out := make([]K, 0, m.size)
+	avlInOrder(m.root, func(key K, value V) bool {
+		out = append(out, key)
+		return true
+	})
+	return out
+}
+
+// GetValues creates an array with all the values stored inside the map,
+// ordered by their key in ascending order.
+func (m *OrderedLockingMap[K, V]) GetValues() []V {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	out := make([]V, 0, m.size)
+	avlInOrder(m.root, func(key K, value V) bool {
+		out = append(out, value)
+		return true
+	})
+	return out
+}
+
+// GetEntries creates an array with all the key and values stored inside
+// the map, sorted by key in ascending order.
+func (m *OrderedLockingMap[K, V]) GetEntries() []Entry[K, V] {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	out := make([]Entry[K, V], 0, m.size)
+	avlInOrder(m.root, func(key K, value V) bool {
+		out = append(out, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+	return out
+}
+
+// SumOf counts up the total number provided from each action function
+// call. Runs the action function on all the contents, in ascending key
+// order
+func (m *OrderedLockingMap[K, V]) SumOf(action func(key K, value V) int) int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	total := 0
+	avlInOrder(m.root, func(key K, value V) bool {
+		total += action(key, value)
+		return true
+	})
+	return total
+}
+
+// MaxOf finds the largest returned result from the action function
+// that is run on all the entries in the map
+func (m *OrderedLockingMap[K, V]) MaxOf(action func(key K, value V) int) int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	max := 0
+	avlInOrder(m.root, func(key K, value V) bool {
+		result := action(key, value)
+		if result > max {
+			max = result
+		}
+		return true
+	})
+	return max
+}
+
+// MinOf finds the smallest returned result from the action function
+// that is run on all the entries in the map
+func (m *OrderedLockingMap[K, V]) MinOf(action func(key K, value V) int) int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	min := -1
+	avlInOrder(m.root, func(key K, value V) bool {
+		result := action(key, value)
+		if min == -1 || result < min {
+			min = result
+		}
+		return true
+	})
+	return min
+}
+
+// GetValuePointers creates an array with pointers to all the values
+// stored inside the map, ordered by their key in ascending order. Each
+// pointer refers to its own copy, backed by a stable slice allocated up
+// front, so they remain distinct once this function returns.
+func (m *OrderedLockingMap[K, V]) GetValuePointers() []*V {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	values := make([]V, 0, m.size)
+	avlInOrder(m.root, func(key K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	out := make([]*V, len(values))
+	for i := range values {
+		out[i] = &values[i]
+	}
+	return out
+}
+
+// GetEntryPointers creates an array with all the key and value pointers
+// stored inside the map, sorted by key in ascending order. Each value
+// pointer refers to its own copy, backed by a stable slice allocated up
+// front, so they remain distinct once this function returns.
+func (m *OrderedLockingMap[K, V]) GetEntryPointers() []Entry[K, *V] {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	values := make([]V, 0, m.size)
+	keys := make([]K, 0, m.size)
+	avlInOrder(m.root, func(key K, value V) bool {
+		keys = append(keys, key)
+		values = append(values, value)
+		return true
+	})
+	out := make([]Entry[K, *V], len(values))
+	for i := range values {
+		out[i] = Entry[K, *V]{Key: keys[i], Value: &values[i]}
+	}
+	return out
+}
+
+// Min returns the smallest key in the map and its value. The third
+// return value is false if the map is empty.
+func (m *OrderedLockingMap[K, V]) Min() (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	if m.root == nil {
+		return k, v, false
+	}
+	n := avlMin(m.root)
+	return n.key, n.value, true
+}
+
+// Max returns the largest key in the map and its value. The third return
+// value is false if the map is empty.
+func (m *OrderedLockingMap[K, V]) Max() (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	if m.root == nil {
+		return k, v, false
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor returns the largest key present that is less than or equal to
+// key, along with its value. The third return value is false if no such
+// key exists.
+func (m *OrderedLockingMap[K, V]) Floor(key K) (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	n := m.root
+	var best *avlNode[K, V]
+	for n != nil {
+		switch {
+		case n.key == key:
+			return n.key, n.value, true
+		case n.key < key:
+			best = n
+			n = n.right
+		default:
+			n = n.left
+		}
+	}
+	if best == nil {
+		return k, v, false
+	}
+	return best.key, best.value, true
+}
+
+// Ceiling returns the smallest key present that is greater than or equal
+// to key, along with its value. The third return value is false if no
+// such key exists.
+func (m *OrderedLockingMap[K, V]) Ceiling(key K) (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	n := m.root
+	var best *avlNode[K, V]
+	for n != nil {
+		switch {
+		case n.key == key:
+			return n.key, n.value, true
+		case n.key > key:
+			best = n
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+	if best == nil {
+		return k, v, false
+	}
+	return best.key, best.value, true
+}
+
+// Lower returns the largest key present that is strictly less than key,
+// along with its value. The third return value is false if no such key
+// exists.
+func (m *OrderedLockingMap[K, V]) Lower(key K) (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	n := m.root
+	var best *avlNode[K, V]
+	for n != nil {
+		if n.key < key {
+			best = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	if best == nil {
+		return k, v, false
+	}
+	return best.key, best.value, true
+}
+
+// Higher returns the smallest key present that is strictly greater than
+// key, along with its value. The third return value is false if no such
+// key exists.
+func (m *OrderedLockingMap[K, V]) Higher(key K) (k K, v V, ok bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	n := m.root
+	var best *avlNode[K, V]
+	for n != nil {
+		if n.key > key {
+			best = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if best == nil {
+		return k, v, false
+	}
+	return best.key, best.value, true
+}
+
+// RangeKeys iterates, in ascending key order, over every entry whose key
+// falls between lo and hi. When inclusive is true both bounds are
+// included, otherwise the range is (lo, hi). Iteration stops early if
+// action returns false.
+func (m *OrderedLockingMap[K, V]) RangeKeys(lo, hi K, inclusive bool, action func(key K, value V) bool) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	avlRangeInOrder(m.root, lo, hi, inclusive, action)
+}
@@ -0,0 +1,251 @@
+package main
+
+import "testing"
+
+func PutJunkDataOrdered(m *OrderedLockingMap[int, int], count int) {
+	for i := 0; i < count; i++ {
+		m.Put(i, i*i)
+	}
+}
+
+func TestOrderedLockingMap_Put(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	m.Put(1, 1)
+	if !m.Contains(1) {
+		t.Log("Expected map to contain key 1")
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_Size(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 20)
+	if m.Size() != 20 {
+		t.Logf("Expected map size to be 20 but got %d instead", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_Remove(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 20)
+	m.Remove(10)
+	if m.Contains(10) {
+		t.Log("Expected key 10 to be removed but it still exists")
+		t.FailNow()
+	}
+	if m.Size() != 19 {
+		t.Logf("Expected map size to be 19 but got %d instead", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_RemoveAndGet(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	m.Put(1, 1)
+
+	value := m.RemoveAndGet(1)
+	if value != 1 {
+		t.Logf("Expected the value of 1 to be 1 got %d instead", value)
+		t.FailNow()
+	}
+	if m.Contains(1) {
+		t.Log("Expected key 1 to be removed but it still exists")
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_RemoveIf(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 10)
+	m.RemoveIf(func(key int, value int) bool {
+		return value < 9
+	})
+	if m.Size() != (10 - 3) {
+		t.Logf("Expected 7 elements to remain after RemoveIf() had %d remaining", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_RemoveUnless(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 10)
+	m.RemoveUnless(func(key int, value int) bool {
+		return value < 9
+	})
+	if m.Size() != 3 {
+		t.Logf("Expected 3 elements to remain after RemoveUnless() had %d remaining", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_Clear(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	PutJunkDataOrdered(m, 10)
+	m.Clear()
+	if m.Size() > 0 {
+		t.Logf("Expected map to be empty after Clear() but had %d elements", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_GetOrDefault(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	m.Put(1, 1)
+	value := m.GetOrDefault(1, 0)
+	if value != 1 {
+		t.Logf("Expected value of 1 to be 1 got %d", value)
+		t.FailNow()
+	}
+	value = m.GetOrDefault(2, -1)
+	if value != -1 {
+		t.Logf("Expected value of 2 to be -1 got %d", value)
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_GetOrCompute(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	m.Put(1, 1)
+	value := m.GetOrCompute(1, func() int {
+		return 2
+	})
+	if value != 1 {
+		t.Logf("Expected the value of 1 to be 1 but got %d instead", value)
+		t.FailNow()
+	}
+	value = m.GetOrCompute(2, func() int {
+		return 2
+	})
+	if value != 2 {
+		t.Logf("Expected the value of 2 to be 2 but got %d instead", value)
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_GetPointer(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	m.Put(1, 5)
+
+	value := m.GetPointer(1)
+	if value == nil || *value != 5 {
+		t.Log("Expected pointer to value 5")
+		t.FailNow()
+	}
+
+	value = m.GetPointer(2)
+	if value != nil {
+		t.Log("Expected 2 to be nil but got", value)
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_GetKeysSorted(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		m.Put(k, k)
+	}
+	keys := m.GetKeys()
+	expected := []int{1, 3, 4, 5, 8}
+	for i, k := range keys {
+		if k != expected[i] {
+			t.Logf("Expected sorted keys %v but got %v", expected, keys)
+			t.FailNow()
+		}
+	}
+}
+
+func TestOrderedLockingMap_MinMax(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for _, k := range []int{5, 3, 8, 1, 4} {
+		m.Put(k, k)
+	}
+	if min, _, ok := m.Min(); !ok || min != 1 {
+		t.Logf("Expected min of 1 but got %d (ok=%v)", min, ok)
+		t.FailNow()
+	}
+	if max, _, ok := m.Max(); !ok || max != 8 {
+		t.Logf("Expected max of 8 but got %d (ok=%v)", max, ok)
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_FloorCeiling(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, k)
+	}
+	if floor, _, ok := m.Floor(25); !ok || floor != 20 {
+		t.Logf("Expected floor(25) to be 20 but got %d (ok=%v)", floor, ok)
+		t.FailNow()
+	}
+	if ceil, _, ok := m.Ceiling(25); !ok || ceil != 30 {
+		t.Logf("Expected ceiling(25) to be 30 but got %d (ok=%v)", ceil, ok)
+		t.FailNow()
+	}
+	if _, _, ok := m.Floor(5); ok {
+		t.Log("Expected floor(5) to not exist")
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_LowerHigher(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, k)
+	}
+	if lower, _, ok := m.Lower(20); !ok || lower != 10 {
+		t.Logf("Expected lower(20) to be 10 but got %d (ok=%v)", lower, ok)
+		t.FailNow()
+	}
+	if higher, _, ok := m.Higher(20); !ok || higher != 30 {
+		t.Logf("Expected higher(20) to be 30 but got %d (ok=%v)", higher, ok)
+		t.FailNow()
+	}
+}
+
+func TestOrderedLockingMap_RangeKeys(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := 0; i < 10; i++ {
+		m.Put(i, i)
+	}
+	var seen []int
+	m.RangeKeys(3, 6, true, func(key int, value int) bool {
+		seen = append(seen, key)
+		return true
+	})
+	expected := []int{3, 4, 5, 6}
+	if len(seen) != len(expected) {
+		t.Logf("Expected range %v but got %v", expected, seen)
+		t.FailNow()
+	}
+	for i, k := range seen {
+		if k != expected[i] {
+			t.Logf("Expected range %v but got %v", expected, seen)
+			t.FailNow()
+		}
+	}
+}
+
+func TestOrderedLockingMap_RangeKeysExclusive(t *testing.T) {
+	m := NewOrderedMap[int, int]()
+	for i := 0; i < 10; i++ {
+		m.Put(i, i)
+	}
+	var seen []int
+	m.RangeKeys(3, 6, false, func(key int, value int) bool {
+		seen = append(seen, key)
+		return true
+	})
+	expected := []int{4, 5}
+	if len(seen) != len(expected) {
+		t.Logf("Expected range %v but got %v", expected, seen)
+		t.FailNow()
+	}
+	for i, k := range seen {
+		if k != expected[i] {
+			t.Logf("Expected range %v but got %v", expected, seen)
+			t.FailNow()
+		}
+	}
+}
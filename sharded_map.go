@@ -0,0 +1,247 @@
+package lmap
+
+import (
+	"sync"
+)
+
+// DefaultShardCount is the number of shards used when a ShardedLockingMap
+// is created without an explicit shard count.
+const DefaultShardCount = 32
+
+// mapShard is a single partition of a ShardedLockingMap. Each shard owns
+// its own lock so operations against different shards never contend.
+type mapShard[K string | uint | int, V any] struct {
+	lock       sync.RWMutex
+	underlying map[K]V
+}
+
+// ShardedLockingMap partitions its entries across a fixed number of shards,
+// each guarded by its own sync.RWMutex, so that concurrent writers touching
+// different shards don't serialize behind a single lock like they do with
+// LockingMap. Use this instead of LockingMap when write throughput under
+// concurrent access matters more than operations that need a full, globally
+// consistent view of the map (see GetEntries).
+type ShardedLockingMap[K string | uint | int, V any] struct {
+	shards []*mapShard[K, V]
+}
+
+// NewShardedMap constructor for creating a new sharded locking map with the
+// provided number of shards. If shards is <= 0, DefaultShardCount is used.
+func NewShardedMap[K string | uint | int, V any](shards int) *ShardedLockingMap[K, V] {
+	if shards <= 0 {
+		shards = DefaultShardCount
+	}
+	out := &ShardedLockingMap[K, V]{
+		shards: make([]*mapShard[K, V], shards),
+	}
+	for i := range out.shards {
+		out.shards[i] = &mapShard[K, V]{underlying: make(map[K]V)}
+	}
+	return out
+}
+
+// shardFor returns the shard responsible for the provided key
+func (m *ShardedLockingMap[K, V]) shardFor(key K) *mapShard[K, V] {
+	return m.shards[shardIndex(key, len(m.shards))]
+}
+
+// shardIndex hashes key and reduces it into the range [0, shardCount)
+func shardIndex[K string | uint | int](key K, shardCount int) int {
+	return int(hashKey(key) % uint64(shardCount))
+}
+
+// hashKey computes a fast, evenly distributed hash for the supported key
+// types: fnv-1a for strings, splitmix64 for ints and uints
+func hashKey[K string | uint | int](key K) uint64 {
+	switch v := any(key).(type) {
+	case string:
+		return fnv1a(v)
+	case int:
+		return splitmix64(uint64(v))
+	case uint:
+		return splitmix64(uint64(v))
+	default:
+		// Unreachable for the K string | uint | int constraint
+		return 0
+	}
+}
+
+// fnv1a computes the 64-bit FNV-1a hash of s
+func fnv1a(s string) uint64 {
+	const offsetBasis uint64 = 14695981039346656037
+	const prime uint64 = 1099511628211
+	h := offsetBasis
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= prime
+	}
+	return h
+}
+
+// splitmix64 computes a single round of the SplitMix64 mixing function
+func splitmix64(x uint64) uint64 {
+	x += 0x9E3779B97F4A7C15
+	x = (x ^ (x >> 30)) * 0xBF58476D1CE4E5B9
+	x = (x ^ (x >> 27)) * 0x94D049BB133111EB
+	return x ^ (x >> 31)
+}
+
+// Put inserts the key value pair into the map.
+func (m *ShardedLockingMap[K, V]) Put(key K, value V) {
+	shard := m.shardFor(key)
+	shard.lock.Lock()
+	shard.underlying[key] = value
+	shard.lock.Unlock()
+}
+
+// PutAll inserts all the provided entries into the map
+func (m *ShardedLockingMap[K, V]) PutAll(entries []Entry[K, V]) {
+	for _, entry := range entries {
+		m.Put(entry.Key, entry.Value)
+	}
+}
+
+// Get retrieves the value from the map using its key. The second
+// return value indicates whether a value is present
+func (m *ShardedLockingMap[K, V]) Get(key K) (V, bool) {
+	shard := m.shardFor(key)
+	shard.lock.RLock()
+	value, exists := shard.underlying[key]
+	shard.lock.RUnlock()
+	return value, exists
+}
+
+// Contains returns whether the map contains the provided key
+func (m *ShardedLockingMap[K, V]) Contains(key K) bool {
+	shard := m.shardFor(key)
+	shard.lock.RLock()
+	_, exists := shard.underlying[key]
+	shard.lock.RUnlock()
+	return exists
+}
+
+// Remove safely removes the key from the underlying map.
+func (m *ShardedLockingMap[K, V]) Remove(key K) {
+	shard := m.shardFor(key)
+	shard.lock.Lock()
+	delete(shard.underlying, key)
+	shard.lock.Unlock()
+}
+
+// Size returns the total number of entries across all shards.
+func (m *ShardedLockingMap[K, V]) Size() int {
+	total := 0
+	for _, shard := range m.shards {
+		shard.lock.RLock()
+		total += len(shard.underlying)
+		shard.lock.RUnlock()
+	}
+	return total
+}
+
+// Clear removes all keys and values from every shard.
+func (m *ShardedLockingMap[K, V]) Clear() {
+	for _, shard := range m.shards {
+		shard.lock.Lock()
+		shard.underlying = make(map[K]V)
+		shard.lock.Unlock()
+	}
+}
+
+// RemoveIf runs the provided action on all the entries in the map, one
+// shard at a time; any calls that return true will be deleted from the
+// underlying map
+func (m *ShardedLockingMap[K, V]) RemoveIf(action func(key K, value V) bool) {
+	for _, shard := range m.shards {
+		shard.lock.Lock()
+		for k, v := range shard.underlying {
+			if action(k, v) {
+				delete(shard.underlying, k)
+			}
+		}
+		shard.lock.Unlock()
+	}
+}
+
+// ForEach iterates over all the key values in the underlying map, one
+// shard at a time, and runs the action function for each of them. Note:
+// DO NOT MODIFY THE MAP WITHIN THIS FUNCTION, the shard currently being
+// visited is held under its read lock for the duration of the call
+func (m *ShardedLockingMap[K, V]) ForEach(action func(key K, value V)) {
+	for _, shard := range m.shards {
+		shard.lock.RLock()
+		for k, v := range shard.underlying {
+			action(k, v)
+		}
+		shard.lock.RUnlock()
+	}
+}
+
+// ForEachParallel runs the action function over every entry in the map,
+// processing shards concurrently with one goroutine per shard. action
+// must be safe to call from multiple goroutines at once. Like ForEach,
+// this is not a consistent point-in-time snapshot of the map: shards are
+// visited independently so concurrent writes to other shards may or may
+// not be observed.
+func (m *ShardedLockingMap[K, V]) ForEachParallel(action func(key K, value V)) {
+	var wg sync.WaitGroup
+	wg.Add(len(m.shards))
+	for _, shard := range m.shards {
+		go func(shard *mapShard[K, V]) {
+			defer wg.Done()
+			shard.lock.RLock()
+			for k, v := range shard.underlying {
+				action(k, v)
+			}
+			shard.lock.RUnlock()
+		}(shard)
+	}
+	wg.Wait()
+}
+
+// GetKeys creates an array with all the keys stored across every shard.
+// The result is not a consistent snapshot: it is assembled by visiting
+// shards one at a time, so writes happening concurrently on a shard not
+// yet visited may or may not be reflected.
+func (m *ShardedLockingMap[K, V]) GetKeys() []K {
+	out := make([]K, 0, m.Size())
+	for _, shard := range m.shards {
+		shard.lock.RLock()
+		for k := range shard.underlying {
+			out = append(out, k)
+		}
+		shard.lock.RUnlock()
+	}
+	return out
+}
+
+// GetValues creates an array with all the values stored across every
+// shard. See GetKeys for the consistency caveat.
+func (m *ShardedLockingMap[K, V]) GetValues() []V {
+	out := make([]V, 0, m.Size())
+	for _, shard := range m.shards {
+		shard.lock.RLock()
+		for _, v := range shard.underlying {
+			out = append(out, v)
+		}
+		shard.lock.RUnlock()
+	}
+	return out
+}
+
+// GetEntries creates an array with all the key and values stored across
+// every shard. See GetKeys for the consistency caveat: because each shard
+// is locked and copied independently, this is never a globally consistent
+// snapshot of the map, unlike LockingMap.GetEntries which locks the whole
+// map for the duration of the copy.
+func (m *ShardedLockingMap[K, V]) GetEntries() []Entry[K, V] {
+	out := make([]Entry[K, V], 0, m.Size())
+	for _, shard := range m.shards {
+		shard.lock.RLock()
+		for k, v := range shard.underlying {
+			out = append(out, Entry[K, V]{Key: k, Value: v})
+		}
+		shard.lock.RUnlock()
+	}
+	return out
+}
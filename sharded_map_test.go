@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func PutJunkDataSharded(m *ShardedLockingMap[string, int], count int) {
+	for i := 0; i < count; i++ {
+		m.Put(fmt.Sprintf("Test%d", i), i)
+	}
+}
+
+func TestShardedLockingMap_Put(t *testing.T) {
+	m := NewShardedMap[string, int](4)
+	m.Put("Test", 1)
+	if !m.Contains("Test") {
+		t.Log("Expected map to contain key 'Test'")
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_Get(t *testing.T) {
+	m := NewShardedMap[string, int](4)
+	m.Put("Test", 1)
+	value, exists := m.Get("Test")
+	if !exists || value != 1 {
+		t.Logf("Expected key 'Test' to have value 1, got %d (exists=%v)", value, exists)
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_Remove(t *testing.T) {
+	m := NewShardedMap[string, int](4)
+	m.Put("Test", 1)
+	m.Remove("Test")
+	if m.Contains("Test") {
+		t.Log("Expected key 'Test' to be removed but it still exists")
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_Size(t *testing.T) {
+	m := NewShardedMap[string, int](8)
+	PutJunkDataSharded(m, 50)
+	if m.Size() != 50 {
+		t.Logf("Expected map size to be 50 but got %d instead", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_DefaultShardCount(t *testing.T) {
+	m := NewShardedMap[string, int](0)
+	if len(m.shards) != DefaultShardCount {
+		t.Logf("Expected %d shards but got %d", DefaultShardCount, len(m.shards))
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_ForEach(t *testing.T) {
+	m := NewShardedMap[string, int](8)
+	PutJunkDataSharded(m, 50)
+	i := 0
+	m.ForEach(func(key string, value int) {
+		i++
+	})
+	if i != 50 {
+		t.Logf("Expected iteration of 50 elements but only iterated %d times", i)
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_ForEachParallel(t *testing.T) {
+	m := NewShardedMap[string, int](8)
+	PutJunkDataSharded(m, 200)
+	var mu sync.Mutex
+	count := 0
+	m.ForEachParallel(func(key string, value int) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+	})
+	if count != 200 {
+		t.Logf("Expected iteration of 200 elements but only iterated %d times", count)
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_RemoveIf(t *testing.T) {
+	m := NewShardedMap[string, int](8)
+	PutJunkDataSharded(m, 10)
+	m.RemoveIf(func(key string, value int) bool {
+		return value < 3
+	})
+	if m.Size() != (10 - 3) {
+		t.Logf("Expected 7 elements to remain after RemoveIf() had %d remaining", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_Clear(t *testing.T) {
+	m := NewShardedMap[string, int](8)
+	PutJunkDataSharded(m, 10)
+	m.Clear()
+	if m.Size() > 0 {
+		t.Logf("Expected map to be empty after Clear() but had %d elements", m.Size())
+		t.FailNow()
+	}
+}
+
+func TestShardedLockingMap_GetKeysValuesEntries(t *testing.T) {
+	expectedKeys := []string{"Test1", "Test2", "Test3"}
+	expectedValues := []int{1, 2, 3}
+	m := NewShardedMap[string, int](4)
+	for i, k := range expectedKeys {
+		m.Put(k, expectedValues[i])
+	}
+	if !ContentEquals(expectedKeys, m.GetKeys()) {
+		t.Log("Keys did not match", expectedKeys, m.GetKeys())
+		t.FailNow()
+	}
+	if !ContentEquals(expectedValues, m.GetValues()) {
+		t.Log("Values did not match", expectedValues, m.GetValues())
+		t.FailNow()
+	}
+	if len(m.GetEntries()) != len(expectedKeys) {
+		t.Log("Entries did not match expected length")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,88 @@
+package lmap
+
+// ImmutableMap is a read-only, point-in-time view over the contents of a
+// LockingMap, obtained via LockingMap.Snapshot. Once created, its entries
+// never change, so none of its methods take any lock: readers can iterate
+// it concurrently with writers still mutating the LockingMap it came from.
+//
+// Snapshot itself copies Underlying at most once per batch of mutations:
+// mutators only flip a dirty flag (see invalidateSnapshot), so repeated
+// Put/Remove calls stay O(1) amortized, and the O(n) copy happens lazily,
+// inside Snapshot, only the first time it's called after something
+// changed. Back-to-back Snapshot calls with no intervening mutation are a
+// lock-free pointer load.
+type ImmutableMap[K string | uint | int, V any] struct {
+	entries map[K]V
+}
+
+// Snapshot returns a point-in-time view of the map's contents. If nothing
+// has changed since the last call, this is a lock-free load of the
+// cached copy; otherwise it takes the read lock just long enough to copy
+// Underlying once, caches the result, and clears the dirty flag so
+// subsequent calls are free again until the next mutation.
+func (m *LockingMap[K, V]) Snapshot() *ImmutableMap[K, V] {
+	if !m.dirty.Load() {
+		if entries := m.snap.Load(); entries != nil {
+			return &ImmutableMap[K, V]{entries: *entries}
+		}
+	}
+
+	m.Lock.RLock()
+	copied := make(map[K]V, len(m.Underlying))
+	for k, v := range m.Underlying {
+		copied[k] = v
+	}
+	m.snap.Store(&copied)
+	m.dirty.Store(false)
+	m.Lock.RUnlock()
+	return &ImmutableMap[K, V]{entries: copied}
+}
+
+// ReplaceAll atomically replaces the entire contents of the map with the
+// entries from the provided snapshot.
+func (m *LockingMap[K, V]) ReplaceAll(snapshot *ImmutableMap[K, V]) {
+	replacement := make(map[K]V, len(snapshot.entries))
+	for k, v := range snapshot.entries {
+		replacement[k] = v
+	}
+	m.Lock.Lock()
+	m.Underlying = replacement
+	m.invalidateSnapshot()
+	m.Lock.Unlock()
+}
+
+// Get retrieves the value from the snapshot using its key. The second
+// return value indicates whether a value is present
+func (s *ImmutableMap[K, V]) Get(key K) (V, bool) {
+	value, exists := s.entries[key]
+	return value, exists
+}
+
+// Contains returns whether the snapshot contains the provided key
+func (s *ImmutableMap[K, V]) Contains(key K) bool {
+	_, exists := s.entries[key]
+	return exists
+}
+
+// Size returns the number of entries in the snapshot
+func (s *ImmutableMap[K, V]) Size() int {
+	return len(s.entries)
+}
+
+// ForEach iterates over all the key values in the snapshot and runs the
+// action function for each of them.
+func (s *ImmutableMap[K, V]) ForEach(action func(key K, value V)) {
+	for k, v := range s.entries {
+		action(k, v)
+	}
+}
+
+// GetEntries creates an array with all the key and values stored inside
+// the snapshot.
+func (s *ImmutableMap[K, V]) GetEntries() []Entry[K, V] {
+	out := make([]Entry[K, V], 0, len(s.entries))
+	for k, v := range s.entries {
+		out = append(out, Entry[K, V]{Key: k, Value: v})
+	}
+	return out
+}
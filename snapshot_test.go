@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestLockingMap_Snapshot(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 10)
+
+	snapshot := m.Snapshot()
+	if snapshot.Size() != 10 {
+		t.Logf("Expected snapshot size to be 10 but got %d instead", snapshot.Size())
+		t.FailNow()
+	}
+
+	m.Put("Test100", 100)
+	if snapshot.Contains("Test100") {
+		t.Log("Expected snapshot to not observe writes made after it was taken")
+		t.FailNow()
+	}
+	if value, exists := snapshot.Get("Test0"); !exists || value != 0 {
+		t.Logf("Expected snapshot to contain 'Test0' with value 0, got %d (exists=%v)", value, exists)
+		t.FailNow()
+	}
+}
+
+func TestLockingMap_ReplaceAll(t *testing.T) {
+	m := NewMap[string, int]()
+	PutJunkData(&m, 5)
+
+	other := NewMap[string, int]()
+	other.Put("Replacement", 1)
+	snapshot := other.Snapshot()
+
+	m.ReplaceAll(snapshot)
+	if m.Size() != 1 || !m.Contains("Replacement") {
+		t.Log("Expected map contents to be entirely replaced by the snapshot")
+		t.FailNow()
+	}
+}